@@ -17,11 +17,14 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/docker/machine/libmachine"
 	"github.com/docker/machine/libmachine/mcnerror"
@@ -42,22 +45,34 @@ import (
 )
 
 const (
-	purge    = "purge"
-	forcedelete = "forcedelete"
+	purge           = "purge"
+	forcedelete     = "forcedelete"
+	keepKubeconfig  = "keep-kubeconfig"
+	keepProfile     = "keep-profile"
+	deleteVolumes   = "delete-volumes"
+	profileSelector = "profile-selector"
 )
 
+// hostPathVolumeDirs are the on-disk locations the storage-provisioner uses to back hostPath PersistentVolumes.
+var hostPathVolumeDirs = []string{"/tmp/hostpath-provisioner", "/tmp/hostpath_pv"}
+
 // deleteCmd represents the delete command
 var deleteCmd = &cobra.Command{
-	Use:   "delete",
+	Use:   "delete [profile]...",
 	Short: "Deletes a local kubernetes cluster",
 	Long: `Deletes a local kubernetes cluster. This command deletes the VM, and removes all
-associated files.`,
+associated files. With no arguments, it deletes the active profile. Pass one or more profile
+names, or --profile-selector, to delete a specific subset of profiles.`,
 	Run: runDelete,
 }
 
 func init() {
 	deleteCmd.Flags().Bool(purge, false, "Set this flag to delete the '.minikube' folder from your user directory. This will prompt for confirmation.")
 	deleteCmd.Flags().Bool(forcedelete, false, "Set this flag to delete all configurations and profiles")
+	deleteCmd.Flags().Bool(keepKubeconfig, false, "Set this flag to leave the kubeconfig context for this profile alone, instead of removing it.")
+	deleteCmd.Flags().Bool(keepProfile, false, "Set this flag to leave the profile's configuration and machine directory alone, instead of removing them.")
+	deleteCmd.Flags().Bool(deleteVolumes, false, "Set this flag to also delete the hostPath PersistentVolume data and any minikube mount directories for this profile.")
+	deleteCmd.Flags().String(profileSelector, "", "Delete only the profiles whose name matches this glob or regular expression pattern, e.g. 'ci-*'")
 
 	if err := viper.BindPFlags(deleteCmd.Flags()); err != nil {
 		exit.WithError("unable to bind flags", err)
@@ -66,29 +81,76 @@ func init() {
 
 // runDelete handles the executes the flow of "minikube delete"
 func runDelete(cmd *cobra.Command, args []string) {
-	if len(args) > 0 {
-		exit.UsageT("Usage: minikube delete")
+	selector := viper.GetString(profileSelector)
+	if len(args) > 0 && selector != "" {
+		exit.UsageT("Usage: minikube delete [profile]... OR minikube delete --profile-selector <pattern>, not both")
 	}
 
 	validProfiles, _, err := pkg_config.ListProfiles()
 
-	// Check if the purge flag has been set but the force flag hasn't been set in case there are multiple profiles to delete.
-	// If the following condition is not met, error out.
-	glog.Infof("%v", viper.IsSet(forcedelete))
-	glog.Infof("%v", viper.GetBool(forcedelete))
-	if err == nil && viper.GetBool(purge) && len(validProfiles) > 1 && !viper.GetBool(forcedelete) {
-		out.T(out.Embarrassed, "Multiple minikube profiles were found - ")
+	var profilesToDelete []pkg_config.Profile
+	switch {
+	case len(args) > 0:
+		if err != nil {
+			exit.WithError("Unable to list profiles", err)
+		}
+		for _, name := range args {
+			p := findProfile(name, validProfiles)
+			if p == nil {
+				out.ErrT(out.Sad, `Profile "{{.name}}" was not found`, out.V{"name": name})
+				os.Exit(1)
+			}
+			profilesToDelete = append(profilesToDelete, *p)
+		}
+	case selector != "":
+		if err != nil {
+			exit.WithError("Unable to list profiles", err)
+		}
 		for _, p := range validProfiles {
-			out.T(out.Notice,"    - {{.profileName}}", out.V{"profileName":p.Name})
+			if matchesSelector(p.Name, selector) {
+				profilesToDelete = append(profilesToDelete, p)
+			}
+		}
+		if len(profilesToDelete) == 0 {
+			out.T(out.Meh, `No profiles matched the selector "{{.selector}}"`, out.V{"selector": selector})
+			return
+		}
+		out.T(out.Notice, `The "{{.selector}}" selector matched the following profiles -`, out.V{"selector": selector})
+		for _, p := range profilesToDelete {
+			out.T(out.Notice, "    - {{.profileName}}", out.V{"profileName": p.Name})
 		}
-		out.T(out.Notice, "Please use the {{.forceFlag}} to delete all of the configuration and the profiles.", out.V{"forceFlag":forcedelete})
-		return
+	default:
+		// Check if the purge flag has been set but the force flag hasn't been set in case there are multiple profiles to delete.
+		// If the following condition is not met, error out.
+		glog.Infof("%v", viper.IsSet(forcedelete))
+		glog.Infof("%v", viper.GetBool(forcedelete))
+		if err == nil && viper.GetBool(purge) && len(validProfiles) > 1 && !viper.GetBool(forcedelete) {
+			out.T(out.Embarrassed, "Multiple minikube profiles were found - ")
+			for _, p := range validProfiles {
+				out.T(out.Notice,"    - {{.profileName}}", out.V{"profileName":p.Name})
+			}
+			out.T(out.Notice, "Please use the {{.forceFlag}} to delete all of the configuration and the profiles.", out.V{"forceFlag":forcedelete})
+			return
+		}
+		if viper.GetBool(purge) {
+			profilesToDelete = validProfiles
+		} else {
+			profilesToDelete = []pkg_config.Profile{{Name: viper.GetString(pkg_config.MachineProfile)}}
+		}
+	}
+
+	keepCfg := viper.GetBool(keepKubeconfig)
+	keepProf := viper.GetBool(keepProfile)
+	if viper.GetBool(purge) && (keepCfg || keepProf) {
+		out.T(out.Notice, "The {{.purgeFlag}} flag overrides {{.keepKubeconfigFlag}} and {{.keepProfileFlag}} - all profile state will be removed", out.V{"purgeFlag": purge, "keepKubeconfigFlag": keepKubeconfig, "keepProfileFlag": keepProfile})
+		keepCfg = false
+		keepProf = false
 	}
 
 	// Perform all the procedure for each of the profiles which are available.
 	// For example, what if there are multiple profiles with multiple clusters running?
-	for _, p := range validProfiles {
-		deleteProfile(p.Name)
+	for _, p := range profilesToDelete {
+		deleteProfile(p.Name, keepCfg, keepProf)
 	}
 
 	// If the purge flag is set, go ahead and delete the .minikube directory.
@@ -101,7 +163,7 @@ func runDelete(cmd *cobra.Command, args []string) {
 	}
 }
 
-func deleteProfile (profile string) {
+func deleteProfile(profile string, keepKubeconfig bool, keepProfile bool) {
 	glog.Infof("Setting current profile to -- %v", profile)
 	err := cmdcfg.Set(pkg_config.MachineProfile, profile)
 	if err != nil {
@@ -125,6 +187,20 @@ func deleteProfile (profile string) {
 		uninstallKubernetes(api, cc.KubernetesConfig, viper.GetString(cmdcfg.Bootstrapper))
 	}
 
+	if viper.GetBool(deleteVolumes) {
+		if err == nil {
+			if verr := deleteHostPathVolumes(api, cc, profile); verr != nil {
+				out.T(out.FailureType, "Failed to delete persistent volume data: {{.error}}", out.V{"error": verr})
+			}
+		} else {
+			glog.Infof("Skipping volume deletion for %q: profile config not available", profile)
+		}
+
+		if verr := deleteMountedDir(); verr != nil {
+			out.T(out.FailureType, "Failed to delete mounted directory: {{.error}}", out.V{"error": verr})
+		}
+	}
+
 	if err := killMountProcess(); err != nil {
 		out.T(out.FailureType, "Failed to kill mount process: {{.error}}", out.V{"error": err})
 	}
@@ -139,21 +215,29 @@ func deleteProfile (profile string) {
 		}
 	}
 
-	// In case DeleteHost didn't complete the job.
-	deleteProfileDirectory(profile)
-
-	if err := pkg_config.DeleteProfile(profile); err != nil {
-		if os.IsNotExist(err) {
-			out.T(out.Meh, `"{{.name}}" profile does not exist`, out.V{"name": profile})
-			os.Exit(0)
+	if keepProfile {
+		out.T(out.Notice, `Leaving the "{{.name}}" profile and machine directory intact`, out.V{"name": profile})
+	} else {
+		// In case DeleteHost didn't complete the job.
+		deleteProfileDirectory(profile)
+
+		if err := pkg_config.DeleteProfile(profile); err != nil {
+			if os.IsNotExist(err) {
+				out.T(out.Meh, `"{{.name}}" profile does not exist`, out.V{"name": profile})
+				os.Exit(0)
+			}
+			exit.WithError("Failed to remove profile", err)
 		}
-		exit.WithError("Failed to remove profile", err)
 	}
 	out.T(out.Crushed, `The "{{.name}}" cluster has been deleted.`, out.V{"name": profile})
 
-	machineName := pkg_config.GetMachineName()
-	if err := kubeconfig.DeleteContext(constants.KubeconfigPath, machineName); err != nil {
-		exit.WithError("update config", err)
+	if keepKubeconfig {
+		out.T(out.Notice, `Leaving the "{{.name}}" kubeconfig context intact`, out.V{"name": profile})
+	} else {
+		machineName := pkg_config.GetMachineName()
+		if err := kubeconfig.DeleteContext(constants.KubeconfigPath, machineName); err != nil {
+			exit.WithError("update config", err)
+		}
 	}
 
 	if err := cmdcfg.Unset(pkg_config.MachineProfile); err != nil {
@@ -161,6 +245,26 @@ func deleteProfile (profile string) {
 	}
 }
 
+// findProfile returns the profile with the given name, or nil if it isn't in profiles.
+func findProfile(name string, profiles []pkg_config.Profile) *pkg_config.Profile {
+	for i, p := range profiles {
+		if p.Name == name {
+			return &profiles[i]
+		}
+	}
+	return nil
+}
+
+// matchesSelector reports whether name matches pattern, treated as a glob and, failing that, a regular expression
+// anchored to the full name - an unanchored match would let e.g. "prod" select "non-prod-test" too.
+func matchesSelector(name, pattern string) bool {
+	if ok, err := filepath.Match(pattern, name); err == nil && ok {
+		return true
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	return err == nil && re.MatchString(name)
+}
+
 func uninstallKubernetes(api libmachine.API, kc pkg_config.KubernetesConfig, bsName string) {
 	out.T(out.Resetting, "Uninstalling Kubernetes {{.kubernetes_version}} using {{.bootstrapper_name}} ...", out.V{"kubernetes_version": kc.KubernetesVersion, "bootstrapper_name": bsName})
 	clusterBootstrapper, err := getClusterBootstrapper(api, bsName)
@@ -191,6 +295,81 @@ func deleteProfileDirectory(profile string) {
 	}
 }
 
+// deleteHostPathVolumes removes the known storage-provisioner root directories (hostPathVolumeDirs), which is
+// where hostPath-backed PersistentVolume data lives. For VM drivers the directories live inside the guest, so
+// they are removed over SSH before the VM is destroyed - once cluster.DeleteHost runs, that data would otherwise
+// be orphaned inside a disk image nothing mounts again.
+func deleteHostPathVolumes(api libmachine.API, cc pkg_config.Config, profile string) error {
+	if cc.MachineConfig.VMDriver == constants.DriverNone {
+		for _, dir := range hostPathVolumeDirs {
+			out.T(out.DeletingHost, `Removing {{.directory}} ...`, out.V{"directory": dir})
+			if err := os.RemoveAll(dir); err != nil {
+				return errors.Wrapf(err, "removing %s", dir)
+			}
+		}
+		return nil
+	}
+
+	machineName := pkg_config.GetMachineName()
+	h, err := api.Load(machineName)
+	if err != nil {
+		return errors.Wrap(err, "loading host")
+	}
+
+	cmd := fmt.Sprintf("sudo rm -rf %s", strings.Join(hostPathVolumeDirs, " "))
+	glog.Infof("Running SSH command on %q: %s", machineName, cmd)
+	if _, err := h.RunSSHCommand(cmd); err != nil {
+		return errors.Wrap(err, "ssh")
+	}
+	return nil
+}
+
+// deleteMountedDir removes the host-side directory backing an active "minikube mount", if one is tracked by the
+// mount process pidfile. The bind-mounted source directory always lives on the host, regardless of VM driver.
+func deleteMountedDir() error {
+	pidPath := filepath.Join(localpath.MiniPath(), constants.MountProcessFileName)
+	if _, err := os.Stat(pidPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	pid, err := ioutil.ReadFile(pidPath)
+	if err != nil {
+		return errors.Wrap(err, "ReadFile")
+	}
+
+	cmdlinePath := filepath.Join("/proc", strings.TrimSpace(string(pid)), "cmdline")
+	raw, err := ioutil.ReadFile(cmdlinePath)
+	if err != nil {
+		glog.Infof("Unable to determine mounted directory for pid %s: %v", pid, err)
+		return nil
+	}
+
+	args := bytes.Split(bytes.Trim(raw, "\x00"), []byte{0})
+	var mountArg string
+	for i := len(args) - 1; i >= 0; i-- {
+		if a := string(args[i]); a != "" && !strings.HasPrefix(a, "-") {
+			mountArg = a
+			break
+		}
+	}
+	if mountArg == "" {
+		glog.Infof("Mount process cmdline did not contain a directory argument: %q", raw)
+		return nil
+	}
+
+	// mountArg is a <hostsrc>:<vmtarget> spec; only the host-source half exists on the host filesystem.
+	dir := mountArg
+	if i := strings.LastIndex(mountArg, ":"); i != -1 {
+		dir = mountArg[:i]
+	}
+
+	out.T(out.DeletingHost, `Removing mounted directory {{.directory}} ...`, out.V{"directory": dir})
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Wrapf(err, "removing %s", dir)
+	}
+	return nil
+}
+
 // killMountProcess kills the mount process, if it is running
 func killMountProcess() error {
 	pidPath := filepath.Join(localpath.MiniPath(), constants.MountProcessFileName)